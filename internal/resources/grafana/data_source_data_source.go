@@ -0,0 +1,169 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	gapi "github.com/grafana/grafana-api-golang-client"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+// DatasourceDataSource returns a read-only Terraform data source for looking
+// up an existing Grafana data source, as a companion to ResourceDataSource
+// in resource_data_source.go. It lets configurations reference data sources
+// that are provisioned outside of this Terraform project (by a Helm chart,
+// the Grafana Cloud control plane, etc.) without having to import them as a
+// managed resource.
+func DatasourceDataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: `
+Looks up an existing Grafana data source by name, UID, or type.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/datasources/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/data_source/)
+`,
+		ReadContext: dataSourceLookupRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the data source. One of `name`, `uid`, or `type` must be set.",
+			},
+			"uid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The UID of the data source. One of `name`, `uid`, or `type` must be set.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The data source type, e.g. `prometheus`. Looked up together with `is_default`. One of `name`, `uid`, or `type` must be set.",
+			},
+			"is_default": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether the looked up data source must be the default data source of its `type`. Only used when looking up by `type`.",
+			},
+			"access_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The method by which Grafana accesses the data source: `proxy` or `direct`.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for the data source.",
+			},
+			"database_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the database used by the data source, if any.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The username used to authenticate to the data source, if any.",
+			},
+			"basic_auth_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether basic auth is enabled for the data source.",
+			},
+			"basic_auth_username": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Basic auth username.",
+			},
+			"json_data_encoded": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Serialized JSON string containing the data source's json data.",
+			},
+			"http_header_keys": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The set of custom HTTP header names configured on the data source. Header values are secret and are never returned by the Grafana API.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLookupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*common.Client).GrafanaAPI
+
+	name := d.Get("name").(string)
+	uid := d.Get("uid").(string)
+	dsType := d.Get("type").(string)
+
+	var dataSource *gapi.DataSource
+	var err error
+
+	switch {
+	case name != "":
+		dataSource, err = client.DataSourceByName(name)
+	case uid != "":
+		dataSource, err = client.DataSourceByUID(uid)
+	case dsType != "":
+		dataSource, err = dataSourceByTypeAndDefault(client, dsType, d.Get("is_default").(bool))
+	default:
+		return diag.Errorf("one of `name`, `uid`, or `type` must be set")
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(dataSource.ID, 10))
+	d.Set("name", dataSource.Name)
+	d.Set("uid", dataSource.UID)
+	d.Set("type", dataSource.Type)
+	d.Set("access_mode", dataSource.Access)
+	d.Set("url", dataSource.URL)
+	d.Set("database_name", dataSource.Database)
+	d.Set("username", dataSource.User)
+	d.Set("basic_auth_enabled", dataSource.BasicAuth)
+	d.Set("basic_auth_username", dataSource.BasicAuthUser)
+
+	gottenJSONData, _, gottenHeaders := gapi.ExtractHeadersFromJSONData(dataSource.JSONData, dataSource.SecureJSONData)
+	encodedJSONData, err := json.Marshal(gottenJSONData)
+	if err != nil {
+		return diag.Errorf("failed to marshal JSON data: %s", err)
+	}
+	d.Set("json_data_encoded", string(encodedJSONData))
+
+	headerKeys := make([]string, 0, len(gottenHeaders))
+	for key := range gottenHeaders {
+		headerKeys = append(headerKeys, key)
+	}
+	d.Set("http_header_keys", headerKeys)
+
+	return nil
+}
+
+// dataSourceByTypeAndDefault looks up a data source by type, requiring it to
+// be the organization's default of that type when isDefault is set. The
+// Grafana API doesn't expose this as a single endpoint, so we list all data
+// sources and filter.
+func dataSourceByTypeAndDefault(client *gapi.Client, dsType string, isDefault bool) (*gapi.DataSource, error) {
+	dataSources, err := client.DataSources()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ds := range dataSources {
+		if ds.Type == dsType && (!isDefault || ds.IsDefault) {
+			return &ds, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no data source of type %q with is_default=%t found", dsType, isDefault)
+}
@@ -0,0 +1,36 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+)
+
+func TestAccDatasourceDataSource_byName(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "grafana_data_source" "test" {
+					type = "prometheus"
+					name = "lookup-acc-test"
+					url  = "http://localhost:9090"
+				}
+
+				data "grafana_data_source" "from_name" {
+					name = grafana_data_source.test.name
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.grafana_data_source.from_name", "uid", "grafana_data_source.test", "uid"),
+					resource.TestCheckResourceAttr("data.grafana_data_source.from_name", "type", "prometheus"),
+					resource.TestCheckResourceAttr("data.grafana_data_source.from_name", "url", "http://localhost:9090"),
+				),
+			},
+		},
+	})
+}
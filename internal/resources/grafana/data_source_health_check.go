@@ -0,0 +1,109 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	gapi "github.com/grafana/grafana-api-golang-client"
+)
+
+// validateDuration validates that a string is parseable by time.ParseDuration,
+// for schema fields that accept Go duration strings like `30s` or `1m`.
+func validateDuration(v interface{}, k string) (warns []string, errs []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%q is not a valid duration: %w", k, err))
+	}
+	return
+}
+
+type dataSourceHealthCheck struct {
+	enabled      bool
+	timeout      time.Duration
+	initialDelay time.Duration
+}
+
+// expandHealthCheck reads the optional health_check block (see
+// dataSourceCommonSchema) off of d, falling back to disabled defaults if it
+// isn't set, and to the default durations if the configured ones don't parse.
+func expandHealthCheck(d *schema.ResourceData) dataSourceHealthCheck {
+	hc := dataSourceHealthCheck{timeout: time.Minute}
+
+	raw, ok := d.GetOk("health_check")
+	if !ok {
+		return hc
+	}
+	blocks := raw.([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return hc
+	}
+	block := blocks[0].(map[string]interface{})
+
+	hc.enabled = block["enabled"].(bool)
+	if timeout, err := time.ParseDuration(block["timeout"].(string)); err == nil {
+		hc.timeout = timeout
+	}
+	if initialDelay, err := time.ParseDuration(block["initial_delay"].(string)); err == nil {
+		hc.initialDelay = initialDelay
+	}
+	return hc
+}
+
+// waitForDataSourceHealthy polls a data source's health check endpoint
+// (GET /api/datasources/uid/{uid}/health) until it reports a healthy status,
+// if health_check.enabled is set. This catches misconfigured URLs, bad
+// credentials, or invalid json_data/secure_json_data at apply time, and
+// smooths over the race where Grafana's datasource proxy hasn't picked up
+// the new configuration yet.
+//
+// client must already be scoped to the organization the data source was
+// provisioned into (see orgScopedClient in data_source_multi_org.go):
+// data source UIDs, and the health endpoint, are per-organization.
+func waitForDataSourceHealthy(ctx context.Context, d *schema.ResourceData, client *gapi.Client) diag.Diagnostics {
+	hc := expandHealthCheck(d)
+	if !hc.enabled {
+		return nil
+	}
+
+	if hc.initialDelay > 0 {
+		select {
+		case <-time.After(hc.initialDelay):
+		case <-ctx.Done():
+			return diag.FromErr(ctx.Err())
+		}
+	}
+
+	uid := d.Get("uid").(string)
+
+	var lastMessage string
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Unknown", "Error", "Waiting"},
+		Target:  []string{"OK"},
+		Timeout: hc.timeout,
+		Delay:   2 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			health, err := client.DataSourceHealth(uid)
+			if err != nil {
+				// The proxy may not have registered the data source yet;
+				// keep polling instead of failing on the first error.
+				lastMessage = err.Error()
+				return nil, "Waiting", nil
+			}
+			lastMessage = health.Message
+			if health.Status == "" {
+				return health, "Unknown", nil
+			}
+			return health, health.Status, nil
+		},
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("data source %q did not report a healthy status: %s (last health message: %q)", d.Get("name").(string), err, lastMessage)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	gapi "github.com/grafana/grafana-api-golang-client"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+)
+
+func TestAccDataSource_healthCheck(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dataSource gapi.DataSource
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      testAccDataSourceCheckDestroy(&dataSource),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "grafana_data_source" "test" {
+					type = "prometheus"
+					name = "health-check-acc-test"
+					url  = "http://localhost:9090"
+
+					health_check {
+						enabled       = true
+						timeout       = "30s"
+						initial_delay = "1s"
+					}
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceCheckExists("grafana_data_source.test", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source.test", "health_check.0.enabled", "true"),
+					resource.TestCheckResourceAttr("grafana_data_source.test", "health_check.0.timeout", "30s"),
+					resource.TestCheckResourceAttr("grafana_data_source.test", "health_check.0.initial_delay", "1s"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,100 @@
+package grafana
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	gapi "github.com/grafana/grafana-api-golang-client"
+)
+
+// resolveDataSourceOrgIDs determines which organizations grafana_data_source
+// should be provisioned into, based on its org_ids/all_orgs attributes. It
+// returns nil for the common case where neither is set, meaning the
+// resource should be managed in the provider's configured organization only.
+func resolveDataSourceOrgIDs(d *schema.ResourceData, client *gapi.Client) ([]int64, error) {
+	if d.Get("all_orgs").(bool) {
+		orgs, err := client.Organizations()
+		if err != nil {
+			return nil, err
+		}
+		orgIDs := make([]int64, len(orgs))
+		for i, org := range orgs {
+			orgIDs[i] = org.ID
+		}
+		sort.Slice(orgIDs, func(i, j int) bool { return orgIDs[i] < orgIDs[j] })
+		return orgIDs, nil
+	}
+
+	rawOrgIDs := d.Get("org_ids").(*schema.Set).List()
+	if len(rawOrgIDs) == 0 {
+		return nil, nil
+	}
+
+	orgIDs := make([]int64, len(rawOrgIDs))
+	for i, v := range rawOrgIDs {
+		orgIDs[i] = int64(v.(int))
+	}
+	sort.Slice(orgIDs, func(i, j int) bool { return orgIDs[i] < orgIDs[j] })
+	return orgIDs, nil
+}
+
+// expandOrgInstanceIDs reads the org_instance_ids map (org ID -> Grafana
+// datasource ID) off of d. It is empty for resources whose schema has no
+// org_instance_ids, such as the typed data source resources.
+func expandOrgInstanceIDs(d *schema.ResourceData) map[string]string {
+	raw, _ := d.Get("org_instance_ids").(map[string]interface{})
+	instanceIDs := make(map[string]string, len(raw))
+	for k, v := range raw {
+		instanceIDs[k] = v.(string)
+	}
+	return instanceIDs
+}
+
+// orgResourceData is the subset of *schema.ResourceData / *schema.ResourceDiff
+// that orgScopedClient needs, so it can be used from both ReadContext-style
+// functions and CustomizeDiff.
+type orgResourceData interface {
+	Id() string
+	Get(string) interface{}
+}
+
+// orgScopedClient resolves which organization d's current ID belongs to
+// (via org_instance_ids, see resolveDataSourceOrgIDs/expandOrgInstanceIDs
+// above) and returns client scoped to that org. For a data source that
+// isn't using org_ids/all_orgs, org_instance_ids is empty and client is
+// returned unchanged.
+func orgScopedClient(d orgResourceData, client *gapi.Client) *gapi.Client {
+	instanceIDs, ok := d.Get("org_instance_ids").(map[string]interface{})
+	if !ok {
+		return client
+	}
+	for orgIDStr, idStr := range instanceIDs {
+		if idStr.(string) != d.Id() {
+			continue
+		}
+		orgID, err := strconv.ParseInt(orgIDStr, 10, 64)
+		if err != nil {
+			continue
+		}
+		return client.WithOrgID(orgID)
+	}
+	return client
+}
+
+// sortedOrgIDs parses the string org IDs keying instanceIDs and returns them
+// in ascending order, so callers get deterministic behavior when picking a
+// "primary" org (e.g. for d.SetId()).
+func sortedOrgIDs(instanceIDs map[string]string) ([]int64, error) {
+	orgIDs := make([]int64, 0, len(instanceIDs))
+	for orgIDStr := range instanceIDs {
+		orgID, err := strconv.ParseInt(orgIDStr, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		orgIDs = append(orgIDs, orgID)
+	}
+	sort.Slice(orgIDs, func(i, j int) bool { return orgIDs[i] < orgIDs[j] })
+	return orgIDs, nil
+}
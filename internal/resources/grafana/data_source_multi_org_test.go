@@ -0,0 +1,199 @@
+package grafana_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+)
+
+// TestAccDataSource_multiOrg covers resolveDataSourceOrgIDs/UpdateDataSource's
+// org fan-out: provisioning into multiple organizations, then removing
+// org_ids entirely, which must leave behind exactly one data source in the
+// provider's default organization rather than deleting everything and
+// stranding the resource's ID.
+func TestAccDataSource_multiOrg(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	orgUIDs := map[string]string{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      testAccDataSourceMultiOrgCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "grafana_organization" "test" {
+					name = "multi-org-acc-test"
+				}
+
+				resource "grafana_data_source" "test" {
+					type     = "prometheus"
+					name     = "multi-org-acc-test"
+					url      = "http://localhost:9090"
+					org_ids  = [1, grafana_organization.test.org_id]
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_data_source.test", "org_ids.#", "2"),
+					resource.TestCheckResourceAttr("grafana_data_source.test", "org_instance_ids.%", "2"),
+					testAccDataSourceMultiOrgCaptureUIDs("grafana_data_source.test", orgUIDs),
+				),
+			},
+			{
+				// An in-place update that keeps the same org_ids must not
+				// clobber each org's independently generated UID with
+				// whichever org happened to be read as "primary".
+				Config: `
+				resource "grafana_organization" "test" {
+					name = "multi-org-acc-test"
+				}
+
+				resource "grafana_data_source" "test" {
+					type     = "prometheus"
+					name     = "multi-org-acc-test"
+					url      = "http://localhost:9091"
+					org_ids  = [1, grafana_organization.test.org_id]
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_data_source.test", "url", "http://localhost:9091"),
+					testAccDataSourceMultiOrgCheckUIDsUnchanged("grafana_data_source.test", orgUIDs),
+				),
+			},
+			{
+				// Dropping org_ids must leave a single default-org instance
+				// behind, not delete the data source out from under the
+				// resource (the bug fixed alongside this test).
+				Config: `
+				resource "grafana_organization" "test" {
+					name = "multi-org-acc-test"
+				}
+
+				resource "grafana_data_source" "test" {
+					type = "prometheus"
+					name = "multi-org-acc-test"
+					url  = "http://localhost:9091"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("grafana_data_source.test", "org_instance_ids.%", "0"),
+					resource.TestCheckResourceAttrSet("grafana_data_source.test", "id"),
+					testAccDataSourceMultiOrgCheckExists("grafana_data_source.test"),
+				),
+			},
+		},
+	})
+}
+
+// testAccDataSourceMultiOrgCaptureUIDs records the UID of every org's
+// instance of rn (keyed by org ID) into uids, for later comparison by
+// testAccDataSourceMultiOrgCheckUIDsUnchanged.
+func testAccDataSourceMultiOrgCaptureUIDs(rn string, uids map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[rn]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", rn)
+		}
+
+		client := testutils.Provider.Meta().(*common.Client).GrafanaAPI
+		for k, v := range rs.Primary.Attributes {
+			const prefix = "org_instance_ids."
+			if !strings.HasPrefix(k, prefix) || k == prefix+"%" {
+				continue
+			}
+			orgIDStr := strings.TrimPrefix(k, prefix)
+			orgID, err := strconv.ParseInt(orgIDStr, 10, 64)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return err
+			}
+			ds, err := client.WithOrgID(orgID).DataSource(id)
+			if err != nil {
+				return fmt.Errorf("error getting data source for org %s: %s", orgIDStr, err)
+			}
+			uids[orgIDStr] = ds.UID
+		}
+		if len(uids) == 0 {
+			return fmt.Errorf("no org_instance_ids found on %s", rn)
+		}
+		return nil
+	}
+}
+
+// testAccDataSourceMultiOrgCheckUIDsUnchanged asserts every org ID recorded
+// in uids still maps to a data source with that same UID.
+func testAccDataSourceMultiOrgCheckUIDsUnchanged(rn string, uids map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[rn]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", rn)
+		}
+
+		client := testutils.Provider.Meta().(*common.Client).GrafanaAPI
+		for orgIDStr, wantUID := range uids {
+			orgID, err := strconv.ParseInt(orgIDStr, 10, 64)
+			if err != nil {
+				return err
+			}
+			idStr, ok := rs.Primary.Attributes["org_instance_ids."+orgIDStr]
+			if !ok {
+				return fmt.Errorf("org %s missing from org_instance_ids after update", orgIDStr)
+			}
+			id, err := strconv.ParseInt(idStr, 10, 64)
+			if err != nil {
+				return err
+			}
+			ds, err := client.WithOrgID(orgID).DataSource(id)
+			if err != nil {
+				return fmt.Errorf("error getting data source for org %s: %s", orgIDStr, err)
+			}
+			if ds.UID != wantUID {
+				return fmt.Errorf("org %s UID changed after update: was %q, now %q", orgIDStr, wantUID, ds.UID)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccDataSourceMultiOrgCheckExists(rn string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[rn]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", rn)
+		}
+
+		client := testutils.Provider.Meta().(*common.Client).GrafanaAPI
+		id, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+		if _, err := client.DataSource(id); err != nil {
+			return fmt.Errorf("expected a default-org data source to exist after dropping org_ids: %s", err)
+		}
+		return nil
+	}
+}
+
+func testAccDataSourceMultiOrgCheckDestroy(s *terraform.State) error {
+	client := testutils.Provider.Meta().(*common.Client).GrafanaAPI
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "grafana_data_source" {
+			continue
+		}
+		id, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+		if _, err := client.DataSource(id); err == nil {
+			return fmt.Errorf("data source %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package grafana
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	gapi "github.com/grafana/grafana-api-golang-client"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+// Grafana never returns secureJsonData values in its API responses, so
+// grafana_data_source can't tell whether a Vault-sourced secret rotated
+// underneath it. As an opt-in signal, we hash the secrets this resource
+// configured and compare that against the secureJsonFields map Grafana
+// *does* return (which just lists which secret keys are currently set),
+// flagging drift when the two disagree. See computeSecureJSONDataDigests,
+// dataSourceSecretDriftWarnings, and resourceDataSourceCustomizeDiff.
+
+// computeSecureJSONDataDigests hashes every secure_json_data_encoded entry
+// configured on d, keyed the same way Grafana keys secureJsonFields, so the
+// digests can be compared against it on read.
+//
+// http_headers aren't included here: gapi.JSONDataWithHeaders (see
+// makeDataSourceWithJSONData) stores header secrets under its own
+// numbered httpHeaderValueN keys, which are assigned by iteration order
+// over a map and so aren't stable across applies. There's no way to
+// reproduce that numbering here, so header values are excluded from drift
+// detection rather than generating permanent false positives.
+func computeSecureJSONDataDigests(d *schema.ResourceData) (map[string]string, error) {
+	digests := map[string]string{}
+
+	sjd, err := makeSecureJSONData(d)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range sjd {
+		normalized, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to normalize secure_json_data_encoded key %q: %w", key, err)
+		}
+		digests[key] = sha256Hex(normalized)
+	}
+
+	return digests, nil
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// dataSourceSecretDriftWarnings compares the digests this resource last
+// computed (stored in secure_json_data_sha256) against dataSource's
+// SecureJSONFields, returning a warning diagnostic per key where they
+// disagree: a key Grafana reports as set that we never configured, or a key
+// we configured that Grafana no longer reports as set.
+func dataSourceSecretDriftWarnings(d *schema.ResourceData, dataSource *gapi.DataSource) diag.Diagnostics {
+	stored := map[string]interface{}{}
+	if v, ok := d.GetOk("secure_json_data_sha256"); ok {
+		stored = v.(map[string]interface{})
+	}
+
+	var drifted []string
+	for key, isSet := range dataSource.SecureJSONFields {
+		// http_headers secrets aren't digested (see computeSecureJSONDataDigests).
+		if _, tracked := stored[key]; isSet && !tracked && !strings.HasPrefix(key, "httpHeaderValue") {
+			drifted = append(drifted, fmt.Sprintf("secret %q is set on the data source but was not configured by this resource", key))
+		}
+	}
+	for key := range stored {
+		if !dataSource.SecureJSONFields[key] {
+			drifted = append(drifted, fmt.Sprintf("secret %q was configured by this resource but is no longer set on the data source", key))
+		}
+	}
+	sort.Strings(drifted)
+
+	var diags diag.Diagnostics
+	for _, msg := range drifted {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Secret drift detected",
+			Detail:   msg,
+		})
+	}
+	return diags
+}
+
+// resourceDataSourceCustomizeDiff, when force_recreate_on_secret_drift is
+// set, re-checks secret drift at plan time and marks the resource for
+// replacement instead of an in-place update if any is found. It's a
+// CustomizeDiff rather than part of ReadContext because forcing a
+// replacement has to happen while the diff is being built.
+func resourceDataSourceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("force_recreate_on_secret_drift").(bool) || d.Id() == "" {
+		return nil
+	}
+
+	client := meta.(*common.Client).GrafanaAPI
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return nil
+	}
+
+	// d.Id() is per-organization: for a multi-org fan-out (see
+	// data_source_multi_org.go), it's whichever org sorted lowest in
+	// org_ids, which isn't necessarily the provider's default org. Look up
+	// the org that instance ID actually belongs to so we don't 404 or,
+	// worse, match an unrelated data source that shares the same numeric ID
+	// in the default org.
+	client = orgScopedClient(d, client)
+
+	dataSource, err := client.DataSource(id)
+	if err != nil {
+		return nil
+	}
+
+	stored := map[string]interface{}{}
+	if v, ok := d.GetOk("secure_json_data_sha256"); ok {
+		stored = v.(map[string]interface{})
+	}
+
+	for key, isSet := range dataSource.SecureJSONFields {
+		if _, tracked := stored[key]; isSet && !tracked && !strings.HasPrefix(key, "httpHeaderValue") {
+			return d.ForceNew("secure_json_data_encoded")
+		}
+	}
+	for key := range stored {
+		if !dataSource.SecureJSONFields[key] {
+			return d.ForceNew("secure_json_data_encoded")
+		}
+	}
+
+	return nil
+}
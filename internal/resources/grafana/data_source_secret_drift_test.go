@@ -0,0 +1,54 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	gapi "github.com/grafana/grafana-api-golang-client"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+)
+
+// TestAccDataSource_secretDrift covers secure_json_data_sha256: it's
+// populated from secure_json_data_encoded, and an in-place update that
+// doesn't touch secrets leaves it at the same single entry.
+func TestAccDataSource_secretDrift(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dataSource gapi.DataSource
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      testAccDataSourceCheckDestroy(&dataSource),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "grafana_data_source" "test" {
+					type                     = "prometheus"
+					name                     = "secret-drift-acc-test"
+					url                      = "http://localhost:9090"
+					secure_json_data_encoded = jsonencode({ httpHeaderValue1 = "s3cr3t" })
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceCheckExists("grafana_data_source.test", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source.test", "secure_json_data_sha256.%", "1"),
+				),
+			},
+			{
+				Config: `
+				resource "grafana_data_source" "test" {
+					type                     = "prometheus"
+					name                     = "secret-drift-acc-test"
+					url                      = "http://localhost:9091"
+					secure_json_data_encoded = jsonencode({ httpHeaderValue1 = "s3cr3t" })
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceCheckExists("grafana_data_source.test", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source.test", "url", "http://localhost:9091"),
+					resource.TestCheckResourceAttr("grafana_data_source.test", "secure_json_data_sha256.%", "1"),
+					resource.TestCheckNoResourceAttr("grafana_data_source.test", "secure_json_data_sha256.http_headers.foo"),
+				),
+			},
+		},
+	})
+}
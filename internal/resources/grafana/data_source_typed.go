@@ -0,0 +1,163 @@
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+)
+
+// jsonDataAttr maps a single top-level attribute of a typed data source
+// resource's schema onto a key in Grafana's `jsonData`/`secureJsonData`
+// blobs, so that the typed resources can reuse the same create/read/update
+// plumbing as the generic `grafana_data_source` resource (makeDataSource in
+// resource_data_source.go) while still exposing validated, plugin-specific
+// fields instead of a raw JSON string.
+type jsonDataAttr struct {
+	// schemaKey is the attribute name in the typed resource's schema.
+	schemaKey string
+	// jsonKey is the corresponding key Grafana expects in jsonData (or
+	// secureJsonData, if secure is set).
+	jsonKey string
+	// secure routes the value through secureJsonData instead of jsonData.
+	// Grafana never returns secureJsonData on read, so these attributes are
+	// write-only: readTypedDataSource leaves whatever is already in state.
+	secure bool
+}
+
+// encodeJSONDataAttrs reads attrs off of d and splits them into the
+// jsonData/secureJsonData maps the Grafana API expects. Zero-valued
+// attributes (unset optional fields) are omitted so they don't clobber
+// server-side defaults.
+func encodeJSONDataAttrs(d *schema.ResourceData, attrs []jsonDataAttr) (map[string]interface{}, map[string]interface{}) {
+	jsonData := map[string]interface{}{}
+	secureJSONData := map[string]interface{}{}
+	for _, a := range attrs {
+		v, ok := d.GetOk(a.schemaKey)
+		if !ok {
+			continue
+		}
+		if a.secure {
+			secureJSONData[a.jsonKey] = v
+		} else {
+			jsonData[a.jsonKey] = v
+		}
+	}
+	return jsonData, secureJSONData
+}
+
+// decodeJSONDataAttrs is the inverse of encodeJSONDataAttrs: it sets the
+// typed schema fields in d from the jsonData map Grafana returned on read.
+func decodeJSONDataAttrs(d *schema.ResourceData, jsonData map[string]interface{}, attrs []jsonDataAttr) {
+	for _, a := range attrs {
+		if a.secure {
+			// Grafana never returns secureJsonData, so there's nothing to sync.
+			continue
+		}
+		if v, ok := jsonData[a.jsonKey]; ok {
+			d.Set(a.schemaKey, v)
+		}
+	}
+}
+
+// newTypedDataSourceResource builds a schema.Resource for a typed,
+// single-plugin data source (grafana_data_source_prometheus and friends).
+// It shares CreateDataSource/UpdateDataSource/DeleteDataSource's underlying
+// API plumbing with the generic grafana_data_source resource, plugging in
+// pluginType and the jsonData produced from attrs instead of a hand-written
+// json_data_encoded string.
+//
+// Note: Terraform has no built-in way to carry state across a resource type
+// change (e.g. from grafana_data_source to grafana_data_source_prometheus) —
+// SchemaVersion/StateUpgraders only apply within a single resource type. To
+// switch an existing data source onto one of these typed resources, import
+// it fresh: `terraform import grafana_data_source_prometheus.x <id-or-uid>`.
+func newTypedDataSourceResource(pluginType, description string, pluginSchema map[string]*schema.Schema, attrs []jsonDataAttr) *schema.Resource {
+	resourceSchema := dataSourceCommonSchema()
+	for k, v := range pluginSchema {
+		resourceSchema[k] = v
+	}
+
+	return &schema.Resource{
+		Description: description,
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return createTypedDataSource(ctx, d, meta, pluginType, attrs)
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return readTypedDataSource(ctx, d, meta, attrs)
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return updateTypedDataSource(ctx, d, meta, pluginType, attrs)
+		},
+		DeleteContext: DeleteDataSource,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: resourceSchema,
+	}
+}
+
+func createTypedDataSource(ctx context.Context, d *schema.ResourceData, meta interface{}, pluginType string, attrs []jsonDataAttr) diag.Diagnostics {
+	client := meta.(*common.Client).GrafanaAPI
+
+	jd, sd := encodeJSONDataAttrs(d, attrs)
+	dataSource, err := makeDataSourceWithJSONData(d, pluginType, jd, sd)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := client.NewDataSource(dataSource)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%d", id))
+
+	diags := readTypedDataSource(ctx, d, meta, attrs)
+	return append(diags, waitForDataSourceHealthy(ctx, d, client)...)
+}
+
+func updateTypedDataSource(ctx context.Context, d *schema.ResourceData, meta interface{}, pluginType string, attrs []jsonDataAttr) diag.Diagnostics {
+	client := meta.(*common.Client).GrafanaAPI
+
+	jd, sd := encodeJSONDataAttrs(d, attrs)
+	dataSource, err := makeDataSourceWithJSONData(d, pluginType, jd, sd)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.UpdateDataSource(dataSource); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return waitForDataSourceHealthy(ctx, d, client)
+}
+
+func readTypedDataSource(ctx context.Context, d *schema.ResourceData, meta interface{}, attrs []jsonDataAttr) diag.Diagnostics {
+	client := meta.(*common.Client).GrafanaAPI
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Invalid id: %#v", d.Id())
+	}
+
+	dataSource, err := client.DataSource(id)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "status: 404") {
+			log.Printf("[WARN] removing datasource %s from state because it no longer exists in grafana", d.Get("name").(string))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	jsonData := readCommonDataSourceFields(d, dataSource)
+	decodeJSONDataAttrs(d, jsonData, attrs)
+
+	return nil
+}
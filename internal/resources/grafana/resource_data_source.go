@@ -17,6 +17,186 @@ import (
 	"github.com/grafana/terraform-provider-grafana/internal/common"
 )
 
+// dataSourceCommonSchema returns the schema fields shared by the generic
+// `grafana_data_source` resource and the typed per-plugin resources (see
+// data_source_typed.go). Fields that are specific to the generic resource
+// (`type`, `json_data_encoded`, `secure_json_data_encoded`, and the multi-org
+// and secret drift attributes) are added by the caller on top of this map.
+func dataSourceCommonSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"access_mode": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "proxy",
+			Description: "The method by which Grafana will access the data source: `proxy` or `direct`.",
+		},
+		"basic_auth_enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether to enable basic auth for the data source.",
+		},
+		"basic_auth_username": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "Basic auth username.",
+		},
+		"database_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "(Required by some data source types) The name of the database to use on the selected data source server.",
+		},
+		"http_headers": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Custom HTTP headers",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"is_default": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether to set the data source as default. This should only be `true` to a single data source.",
+		},
+		"uid": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Unique identifier. If unset, this will be automatically generated.",
+		},
+		"health_check": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Poll the data source's health check endpoint after it is created or updated, and fail the apply if it doesn't report a healthy status within the configured timeout. This surfaces misconfigured URLs, bad credentials, or invalid `json_data`/`secure_json_data` at apply time instead of on the first dashboard query.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "Whether to perform the health check. Defaults to `false`.",
+					},
+					"timeout": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "1m",
+						ValidateFunc: validateDuration,
+						Description:  "How long to keep polling the health check endpoint for a healthy status before failing the apply, e.g. `1m`. Defaults to `1m`.",
+					},
+					"initial_delay": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "0s",
+						ValidateFunc: validateDuration,
+						Description:  "How long to wait before issuing the first health check request, to give Grafana's data source proxy time to pick up the new configuration. Defaults to `0s`.",
+					},
+				},
+			},
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "A unique name for the data source.",
+		},
+		"url": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The URL for the data source. The type of URL required varies depending on the chosen data source type.",
+		},
+		"username": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "(Required by some data source types) The username to use to authenticate to the data source.",
+		},
+	}
+}
+
+// genericDataSourceSchema returns the full schema for the generic
+// `grafana_data_source` resource: the common fields plus the untyped `type`,
+// the raw JSON escape hatches, and the multi-org and secret drift attributes.
+func genericDataSourceSchema() map[string]*schema.Schema {
+	s := dataSourceCommonSchema()
+	s["type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The data source type. Must be one of the supported data source keywords.",
+	}
+	s["json_data_encoded"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Serialized JSON string containing the json data. This attribute can be used to pass configuration options to the data source. To figure out what options a datasource has available, see its docs or inspect the network data when saving it from the Grafana UI. Note that keys in this map are usually camelCased.",
+		ValidateFunc: validation.StringIsJSON,
+		StateFunc: func(v interface{}) string {
+			json, _ := structure.NormalizeJsonString(v)
+			return json
+		},
+		DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+			if oldValue == "{}" && newValue == "" {
+				return true
+			}
+			return common.SuppressEquivalentJSONDiffs(k, oldValue, newValue, d)
+		},
+	}
+	s["secure_json_data_encoded"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Sensitive:    true,
+		Description:  "Serialized JSON string containing the secure json data. This attribute can be used to pass secure configuration options to the data source. To figure out what options a datasource has available, see its docs or inspect the network data when saving it from the Grafana UI. Note that keys in this map are usually camelCased.",
+		ValidateFunc: validation.StringIsJSON,
+		StateFunc: func(v interface{}) string {
+			json, _ := structure.NormalizeJsonString(v)
+			return json
+		},
+		DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+			if oldValue == "{}" && newValue == "" {
+				return true
+			}
+			return common.SuppressEquivalentJSONDiffs(k, oldValue, newValue, d)
+		},
+	}
+	s["org_ids"] = &schema.Schema{
+		Type:          schema.TypeSet,
+		Optional:      true,
+		Elem:          &schema.Schema{Type: schema.TypeInt},
+		ConflictsWith: []string{"all_orgs"},
+		Description:   "A list of organization IDs to provision this data source into. The same data source (name, type, and configuration) is created independently in each organization. Conflicts with `all_orgs`. If neither is set, the data source is managed in the provider's configured organization only. Setting `org_ids` on a data source that was created without `org_ids` or `all_orgs` only updates the existing instance; no per-organization instances are created.",
+	}
+	s["all_orgs"] = &schema.Schema{
+		Type:          schema.TypeBool,
+		Optional:      true,
+		Default:       false,
+		ConflictsWith: []string{"org_ids"},
+		Description:   "Provision this data source into every organization on the Grafana instance. Conflicts with `org_ids`. Setting `all_orgs` on a data source that was created without `org_ids` or `all_orgs` only updates the existing instance; no per-organization instances are created.",
+	}
+	s["org_instance_ids"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Map of organization ID (as a string) to the Grafana-assigned data source ID provisioned in that organization. Only populated when `org_ids` or `all_orgs` is set.",
+	}
+	s["secure_json_data_sha256"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "A SHA-256 digest per `secure_json_data_encoded` key that this resource last configured. Compared on read against the `secureJsonFields` Grafana reports to detect secrets that drifted out-of-band, since Grafana never returns the secret values themselves. Does not cover `http_headers` values.",
+	}
+	s["force_recreate_on_secret_drift"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "When `true`, if `secure_json_data_sha256` drift is detected (see above), mark this resource for replacement instead of an in-place update. Defaults to `false`, which only surfaces the drift as a warning.",
+	}
+	return s
+}
+
 func ResourceDataSource() *schema.Resource {
 	return &schema.Resource{
 
@@ -26,137 +206,69 @@ func ResourceDataSource() *schema.Resource {
 
 The required arguments for this resource vary depending on the type of data
 source selected (via the 'type' argument).
+
+For data sources that are managed through a dedicated, typed resource (such
+as ` + "`grafana_data_source_prometheus`" + ` or ` + "`grafana_data_source_loki`" + `), prefer
+that resource instead: it validates plugin-specific options at plan time
+rather than requiring a hand-built ` + "`json_data_encoded`" + ` blob.
 `,
 
 		CreateContext: CreateDataSource,
 		UpdateContext: UpdateDataSource,
 		DeleteContext: DeleteDataSource,
 		ReadContext:   ReadDataSource,
+		CustomizeDiff: resourceDataSourceCustomizeDiff,
 		SchemaVersion: 1,
 
-		// Import either by ID or UID
+		// Import by ID or UID, optionally prefixed with "org_id:" for a data
+		// source provisioned into a non-default organization.
 		Importer: &schema.ResourceImporter{
 			StateContext: func(c context.Context, rd *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				_, err := strconv.ParseInt(rd.Id(), 10, 64)
-				if err != nil {
-					// If the ID is not a number, then it may be a UID
-					client := meta.(*common.Client).GrafanaAPI
-					ds, err := client.DataSourceByUID(rd.Id())
+				client := meta.(*common.Client).GrafanaAPI
+
+				idOrUID := rd.Id()
+				var orgID int64
+				if parts := strings.SplitN(rd.Id(), ":", 2); len(parts) == 2 {
+					parsedOrgID, err := strconv.ParseInt(parts[0], 10, 64)
 					if err != nil {
-						return nil, fmt.Errorf("failed to find datasource by ID or UID '%s': %w", rd.Id(), err)
+						return nil, fmt.Errorf("invalid org_id in import ID '%s': %w", rd.Id(), err)
 					}
-					rd.SetId(strconv.FormatInt(ds.ID, 10))
+					orgID = parsedOrgID
+					idOrUID = parts[1]
+					client = client.WithOrgID(orgID)
+				}
+
+				var ds *gapi.DataSource
+				var err error
+				if id, idErr := strconv.ParseInt(idOrUID, 10, 64); idErr == nil {
+					ds, err = client.DataSource(id)
+				} else {
+					// If the ID is not a number, then it may be a UID
+					ds, err = client.DataSourceByUID(idOrUID)
+				}
+				if err != nil {
+					return nil, fmt.Errorf("failed to find datasource by ID or UID '%s': %w", idOrUID, err)
+				}
+
+				rd.SetId(strconv.FormatInt(ds.ID, 10))
+				if orgID != 0 {
+					rd.Set("org_ids", []interface{}{orgID})
+					rd.Set("org_instance_ids", map[string]interface{}{
+						strconv.FormatInt(orgID, 10): strconv.FormatInt(ds.ID, 10),
+					})
 				}
 				return []*schema.ResourceData{rd}, nil
 			},
 		},
 
-		Schema: map[string]*schema.Schema{
-			"access_mode": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "proxy",
-				Description: "The method by which Grafana will access the data source: `proxy` or `direct`.",
-			},
-			"basic_auth_enabled": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Whether to enable basic auth for the data source.",
-			},
-			"basic_auth_username": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "",
-				Description: "Basic auth username.",
-			},
-			"database_name": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "",
-				Description: "(Required by some data source types) The name of the database to use on the selected data source server.",
-			},
-			"http_headers": {
-				Type:        schema.TypeMap,
-				Optional:    true,
-				Sensitive:   true,
-				Description: "Custom HTTP headers",
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-			},
-			"is_default": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Whether to set the data source as default. This should only be `true` to a single data source.",
-			},
-			"uid": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				ForceNew:    true,
-				Description: "Unique identifier. If unset, this will be automatically generated.",
-			},
-			"name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "A unique name for the data source.",
-			},
-			"type": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The data source type. Must be one of the supported data source keywords.",
-			},
-			"url": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The URL for the data source. The type of URL required varies depending on the chosen data source type.",
-			},
-			"username": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "",
-				Description: "(Required by some data source types) The username to use to authenticate to the data source.",
-			},
-			"json_data_encoded": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "Serialized JSON string containing the json data. This attribute can be used to pass configuration options to the data source. To figure out what options a datasource has available, see its docs or inspect the network data when saving it from the Grafana UI. Note that keys in this map are usually camelCased.",
-				ValidateFunc: validation.StringIsJSON,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
-				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
-					if oldValue == "{}" && newValue == "" {
-						return true
-					}
-					return common.SuppressEquivalentJSONDiffs(k, oldValue, newValue, d)
-				},
-			},
-			"secure_json_data_encoded": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Sensitive:    true,
-				Description:  "Serialized JSON string containing the secure json data. This attribute can be used to pass secure configuration options to the data source. To figure out what options a datasource has available, see its docs or inspect the network data when saving it from the Grafana UI. Note that keys in this map are usually camelCased.",
-				ValidateFunc: validation.StringIsJSON,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
-				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
-					if oldValue == "{}" && newValue == "" {
-						return true
-					}
-					return common.SuppressEquivalentJSONDiffs(k, oldValue, newValue, d)
-				},
-			},
-		},
+		Schema: genericDataSourceSchema(),
 	}
 }
 
-// CreateDataSource creates a Grafana datasource
+// CreateDataSource creates a Grafana datasource. When org_ids or all_orgs is
+// set, the same data source is created independently in each organization
+// (see data_source_multi_org.go) and d.Id() is set to the instance created
+// in the lowest org ID, with the full per-org mapping in org_instance_ids.
 func CreateDataSource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.Client).GrafanaAPI
 
@@ -165,17 +277,44 @@ func CreateDataSource(ctx context.Context, d *schema.ResourceData, meta interfac
 		return diag.FromErr(err)
 	}
 
-	id, err := client.NewDataSource(dataSource)
+	orgIDs, err := resolveDataSourceOrgIDs(d, client)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(strconv.FormatInt(id, 10))
+	if len(orgIDs) == 0 {
+		id, err := client.NewDataSource(dataSource)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(strconv.FormatInt(id, 10))
+	} else {
+		instanceIDs := make(map[string]string, len(orgIDs))
+		for _, orgID := range orgIDs {
+			id, err := client.WithOrgID(orgID).NewDataSource(dataSource)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			instanceIDs[strconv.FormatInt(orgID, 10)] = strconv.FormatInt(id, 10)
+		}
+		d.Set("org_instance_ids", instanceIDs)
+		d.SetId(instanceIDs[strconv.FormatInt(orgIDs[0], 10)])
+	}
+
+	digests, err := computeSecureJSONDataDigests(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("secure_json_data_sha256", digests)
 
-	return ReadDataSource(ctx, d, meta)
+	diags := ReadDataSource(ctx, d, meta)
+	return append(diags, waitForDataSourceHealthy(ctx, d, orgScopedClient(d, client))...)
 }
 
-// UpdateDataSource updates a Grafana datasource
+// UpdateDataSource updates a Grafana datasource. When org_instance_ids is
+// populated, each org's instance is updated with its own org-scoped client,
+// provisioning new instances for orgs newly added to org_ids/all_orgs and
+// deleting instances for orgs that were removed.
 func UpdateDataSource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.Client).GrafanaAPI
 
@@ -184,70 +323,249 @@ func UpdateDataSource(ctx context.Context, d *schema.ResourceData, meta interfac
 		return diag.FromErr(err)
 	}
 
-	if err = client.UpdateDataSource(dataSource); err != nil {
+	digests, err := computeSecureJSONDataDigests(d)
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return diag.Diagnostics{}
+	existingInstanceIDs := expandOrgInstanceIDs(d)
+	if len(existingInstanceIDs) == 0 {
+		if err := client.UpdateDataSource(dataSource); err != nil {
+			return diag.FromErr(err)
+		}
+		d.Set("secure_json_data_sha256", digests)
+		return waitForDataSourceHealthy(ctx, d, client)
+	}
+
+	orgIDs, err := resolveDataSourceOrgIDs(d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(orgIDs) == 0 {
+		// org_ids/all_orgs was removed from config: provision a single
+		// default-org instance before tearing down the per-org ones, so the
+		// resource doesn't end up pointing at nothing. UID is left unset so
+		// Grafana generates a fresh one, rather than risking a collision
+		// with one of the per-org instances being deleted below.
+		newDataSource := *dataSource
+		newDataSource.UID = ""
+		id, err := client.NewDataSource(&newDataSource)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for orgIDStr, idStr := range existingInstanceIDs {
+			orgID, err := strconv.ParseInt(orgIDStr, 10, 64)
+			if err != nil {
+				return diag.Errorf("invalid org_id %q in org_instance_ids: %s", orgIDStr, err)
+			}
+			oldID, err := strconv.ParseInt(idStr, 10, 64)
+			if err != nil {
+				return diag.Errorf("invalid data source id %q for org %s: %s", idStr, orgIDStr, err)
+			}
+			if err := client.WithOrgID(orgID).DeleteDataSource(oldID); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		d.Set("org_instance_ids", map[string]string{})
+		d.Set("secure_json_data_sha256", digests)
+		d.SetId(strconv.FormatInt(id, 10))
+		return waitForDataSourceHealthy(ctx, d, client)
+	}
+
+	instanceIDs := make(map[string]string, len(orgIDs))
+	for _, orgID := range orgIDs {
+		orgIDStr := strconv.FormatInt(orgID, 10)
+		orgClient := client.WithOrgID(orgID)
+		if idStr, ok := existingInstanceIDs[orgIDStr]; ok {
+			id, err := strconv.ParseInt(idStr, 10, 64)
+			if err != nil {
+				return diag.Errorf("invalid data source id %q for org %s: %s", idStr, orgIDStr, err)
+			}
+			// dataSource.UID reflects whichever org's instance was last
+			// read as "primary" (see ReadDataSource); pushing that same
+			// UID to every org would clobber each org's independently
+			// generated UID, breaking anything in that org referencing it.
+			// Look up this org's own current UID and keep it instead.
+			existing, err := orgClient.DataSource(id)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			orgDataSource := *dataSource
+			orgDataSource.ID = id
+			orgDataSource.UID = existing.UID
+			if err := orgClient.UpdateDataSource(&orgDataSource); err != nil {
+				return diag.FromErr(err)
+			}
+			instanceIDs[orgIDStr] = idStr
+		} else {
+			orgDataSource := *dataSource
+			orgDataSource.ID = 0
+			orgDataSource.UID = ""
+			id, err := orgClient.NewDataSource(&orgDataSource)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			instanceIDs[orgIDStr] = strconv.FormatInt(id, 10)
+		}
+	}
+	for orgIDStr, idStr := range existingInstanceIDs {
+		if _, kept := instanceIDs[orgIDStr]; kept {
+			continue
+		}
+		orgID, err := strconv.ParseInt(orgIDStr, 10, 64)
+		if err != nil {
+			return diag.Errorf("invalid org_id %q in org_instance_ids: %s", orgIDStr, err)
+		}
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			return diag.Errorf("invalid data source id %q for org %s: %s", idStr, orgIDStr, err)
+		}
+		if err := client.WithOrgID(orgID).DeleteDataSource(id); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.Set("org_instance_ids", instanceIDs)
+	d.Set("secure_json_data_sha256", digests)
+	d.SetId(instanceIDs[strconv.FormatInt(orgIDs[0], 10)])
+
+	return waitForDataSourceHealthy(ctx, d, orgScopedClient(d, client))
 }
 
-// ReadDataSource reads a Grafana datasource
+// ReadDataSource reads a Grafana datasource. When org_instance_ids is
+// populated, every org's instance is read with its own org-scoped client;
+// instances that 404 are dropped from the map instead of removing the whole
+// resource, so drift in a single organization doesn't affect the others.
 func ReadDataSource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.Client).GrafanaAPI
 
-	idStr := d.Id()
-	id, err := strconv.ParseInt(idStr, 10, 64)
-	if err != nil {
-		return diag.Errorf("Invalid id: %#v", idStr)
+	instanceIDs := expandOrgInstanceIDs(d)
+	if len(instanceIDs) == 0 {
+		idStr := d.Id()
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			return diag.Errorf("Invalid id: %#v", idStr)
+		}
+
+		dataSource, err := client.DataSource(id)
+		if err != nil {
+			if strings.HasPrefix(err.Error(), "status: 404") {
+				log.Printf("[WARN] removing datasource %s from state because it no longer exists in grafana", d.Get("name").(string))
+				d.SetId("")
+				return nil
+			}
+			return diag.FromErr(err)
+		}
+
+		return readDatasource(d, dataSource)
 	}
 
-	dataSource, err := client.DataSource(id)
+	orgIDs, err := sortedOrgIDs(instanceIDs)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "status: 404") {
-			log.Printf("[WARN] removing datasource %s from state because it no longer exists in grafana", d.Get("name").(string))
-			d.SetId("")
-			return nil
-		}
 		return diag.FromErr(err)
 	}
 
-	return readDatasource(d, dataSource)
+	remaining := make(map[string]string, len(instanceIDs))
+	var primary *gapi.DataSource
+	for _, orgID := range orgIDs {
+		orgIDStr := strconv.FormatInt(orgID, 10)
+		idStr := instanceIDs[orgIDStr]
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			return diag.Errorf("invalid data source id %q for org %s: %s", idStr, orgIDStr, err)
+		}
+
+		dataSource, err := client.WithOrgID(orgID).DataSource(id)
+		if err != nil {
+			if strings.HasPrefix(err.Error(), "status: 404") {
+				log.Printf("[WARN] removing datasource %s from org %s state because it no longer exists in grafana", d.Get("name").(string), orgIDStr)
+				continue
+			}
+			return diag.FromErr(err)
+		}
+		remaining[orgIDStr] = idStr
+		if primary == nil {
+			primary = dataSource
+		}
+	}
+
+	if primary == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("org_instance_ids", remaining)
+	return readDatasource(d, primary)
 }
 
-// DeleteDataSource deletes a Grafana datasource
+// DeleteDataSource deletes a Grafana datasource. When org_instance_ids is
+// populated, every org's instance is deleted with its own org-scoped client.
 func DeleteDataSource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*common.Client).GrafanaAPI
 
-	idStr := d.Id()
-	id, err := strconv.ParseInt(idStr, 10, 64)
-	if err != nil {
-		return diag.Errorf("Invalid id: %#v", idStr)
+	instanceIDs := expandOrgInstanceIDs(d)
+	if len(instanceIDs) == 0 {
+		idStr := d.Id()
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			return diag.Errorf("Invalid id: %#v", idStr)
+		}
+
+		if err = client.DeleteDataSource(id); err != nil {
+			return diag.FromErr(err)
+		}
+		return diag.Diagnostics{}
 	}
 
-	if err = client.DeleteDataSource(id); err != nil {
-		return diag.FromErr(err)
+	for orgIDStr, idStr := range instanceIDs {
+		orgID, err := strconv.ParseInt(orgIDStr, 10, 64)
+		if err != nil {
+			return diag.Errorf("invalid org_id %q in org_instance_ids: %s", orgIDStr, err)
+		}
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			return diag.Errorf("invalid data source id %q for org %s: %s", idStr, orgIDStr, err)
+		}
+		if err := client.WithOrgID(orgID).DeleteDataSource(id); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return diag.Diagnostics{}
 }
 
 func readDatasource(d *schema.ResourceData, dataSource *gapi.DataSource) diag.Diagnostics {
+	gottenJSONData := readCommonDataSourceFields(d, dataSource)
+	d.Set("type", dataSource.Type)
+
+	encodedJSONData, err := json.Marshal(gottenJSONData)
+	if err != nil {
+		return diag.Errorf("Failed to marshal JSON data: %s", err)
+	}
+	d.Set("json_data_encoded", string(encodedJSONData))
+
+	return dataSourceSecretDriftWarnings(d, dataSource)
+}
+
+// readCommonDataSourceFields sets the schema fields shared by every data
+// source resource (see dataSourceCommonSchema) from the API response, and
+// returns the plugin-specific jsonData map so callers (readDatasource above,
+// and the typed resources in data_source_typed.go) can decode their own
+// fields out of it.
+func readCommonDataSourceFields(d *schema.ResourceData, dataSource *gapi.DataSource) map[string]interface{} {
 	d.SetId(strconv.FormatInt(dataSource.ID, 10))
 	d.Set("access_mode", dataSource.Access)
 	d.Set("database_name", dataSource.Database)
 	d.Set("is_default", dataSource.IsDefault)
 	d.Set("name", dataSource.Name)
-	d.Set("type", dataSource.Type)
 	d.Set("url", dataSource.URL)
 	d.Set("username", dataSource.User)
 	d.Set("uid", dataSource.UID)
+	d.Set("basic_auth_enabled", dataSource.BasicAuth)
+	d.Set("basic_auth_username", dataSource.BasicAuthUser)
 
 	gottenJSONData, _, gottenHeaders := gapi.ExtractHeadersFromJSONData(dataSource.JSONData, dataSource.SecureJSONData)
-	encodedJSONData, err := json.Marshal(gottenJSONData)
-	if err != nil {
-		return diag.Errorf("Failed to marshal JSON data: %s", err)
-	}
-	d.Set("json_data_encoded", string(encodedJSONData))
 
 	// For headers, we do not know the value (the API does not return secret data)
 	// so we only remove keys from the state that are no longer present in the API.
@@ -261,13 +579,28 @@ func readDatasource(d *schema.ResourceData, dataSource *gapi.DataSource) diag.Di
 		d.Set("http_headers", currentHeaders)
 	}
 
-	d.Set("basic_auth_enabled", dataSource.BasicAuth)
-	d.Set("basic_auth_username", dataSource.BasicAuthUser)
-
-	return nil
+	return gottenJSONData
 }
 
 func makeDataSource(d *schema.ResourceData) (*gapi.DataSource, error) {
+	jd, err := makeJSONData(d)
+	if err != nil {
+		return nil, err
+	}
+	sd, err := makeSecureJSONData(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return makeDataSourceWithJSONData(d, d.Get("type").(string), jd, sd)
+}
+
+// makeDataSourceWithJSONData builds the gapi.DataSource that the generic and
+// typed data source resources send to the Grafana API. It reads the fields
+// shared by every data source type (see dataSourceCommonSchema) off of d and
+// merges in the plugin-specific jsonData/secureJsonData produced by the
+// caller, along with any configured http_headers.
+func makeDataSourceWithJSONData(d *schema.ResourceData, dsType string, jd, sd map[string]interface{}) (*gapi.DataSource, error) {
 	idStr := d.Id()
 	var id int64
 	var err error
@@ -283,21 +616,12 @@ func makeDataSource(d *schema.ResourceData) (*gapi.DataSource, error) {
 		httpHeaders[key] = fmt.Sprintf("%v", value)
 	}
 
-	jd, err := makeJSONData(d)
-	if err != nil {
-		return nil, err
-	}
-	sd, err := makeSecureJSONData(d)
-	if err != nil {
-		return nil, err
-	}
-
 	jd, sd = gapi.JSONDataWithHeaders(jd, sd, httpHeaders)
 
 	return &gapi.DataSource{
 		ID:             id,
 		Name:           d.Get("name").(string),
-		Type:           d.Get("type").(string),
+		Type:           dsType,
 		URL:            d.Get("url").(string),
 		Access:         d.Get("access_mode").(string),
 		Database:       d.Get("database_name").(string),
@@ -308,7 +632,7 @@ func makeDataSource(d *schema.ResourceData) (*gapi.DataSource, error) {
 		UID:            d.Get("uid").(string),
 		JSONData:       jd,
 		SecureJSONData: sd,
-	}, err
+	}, nil
 }
 
 func makeJSONData(d *schema.ResourceData) (map[string]interface{}, error) {
@@ -0,0 +1,78 @@
+package grafana
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var dataSourceCloudWatchJSONDataAttrs = []jsonDataAttr{
+	{schemaKey: "default_region", jsonKey: "defaultRegion"},
+	{schemaKey: "auth_type", jsonKey: "authType"},
+	{schemaKey: "assume_role_arn", jsonKey: "assumeRoleArn"},
+	{schemaKey: "external_id", jsonKey: "externalId"},
+	{schemaKey: "profile", jsonKey: "profile"},
+	{schemaKey: "access_key", jsonKey: "accessKey", secure: true},
+	{schemaKey: "secret_key", jsonKey: "secretKey", secure: true},
+}
+
+// ResourceDataSourceCloudWatch returns a typed data source resource for
+// Grafana's built-in CloudWatch plugin. See data_source_typed.go for the
+// plumbing shared with the other `grafana_data_source_*` resources.
+func ResourceDataSourceCloudWatch() *schema.Resource {
+	return newTypedDataSourceResource(
+		"cloudwatch",
+		`
+Manages a Grafana data source for Amazon CloudWatch.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/datasources/aws-cloudwatch/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/data_source/)
+
+This is a typed alternative to `+"`grafana_data_source`"+` (`+"`type = \"cloudwatch\"`"+`): it
+exposes CloudWatch-specific options, including its sigv4 auth modes, as
+plain Terraform attributes instead of a hand-built
+`+"`json_data_encoded`"+` blob.
+`,
+		map[string]*schema.Schema{
+			"default_region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The default AWS region to query, e.g. `us-east-1`.",
+			},
+			"auth_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "default",
+				ValidateFunc: validation.StringInSlice([]string{"default", "keys", "credentials", "arn"}, false),
+				Description:  "The AWS authentication provider to use: `default`, `keys`, `credentials` or `arn`. Defaults to `default`.",
+			},
+			"assume_role_arn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ARN of the IAM role to assume, used when `auth_type` is `arn`.",
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The external ID to use when assuming `assume_role_arn` in a third-party AWS account.",
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The AWS credentials profile to use, used when `auth_type` is `credentials`.",
+			},
+			"access_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The AWS access key, used when `auth_type` is `keys`.",
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The AWS secret key, used when `auth_type` is `keys`.",
+			},
+		},
+		dataSourceCloudWatchJSONDataAttrs,
+	)
+}
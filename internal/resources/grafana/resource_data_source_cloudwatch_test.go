@@ -0,0 +1,53 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	gapi "github.com/grafana/grafana-api-golang-client"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+)
+
+func TestAccDataSourceCloudWatch_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dataSource gapi.DataSource
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      testAccDataSourceCheckDestroy(&dataSource),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "grafana_data_source_cloudwatch" "test" {
+					name           = "cloudwatch-acc-test"
+					default_region = "us-east-1"
+					auth_type      = "default"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceCheckExists("grafana_data_source_cloudwatch.test", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source_cloudwatch.test", "default_region", "us-east-1"),
+					resource.TestCheckResourceAttr("grafana_data_source_cloudwatch.test", "auth_type", "default"),
+				),
+			},
+			{
+				Config: `
+				resource "grafana_data_source_cloudwatch" "test" {
+					name            = "cloudwatch-acc-test"
+					default_region  = "us-west-2"
+					auth_type       = "arn"
+					assume_role_arn = "arn:aws:iam::123456789012:role/acc-test"
+					external_id     = "acc-test-external-id"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceCheckExists("grafana_data_source_cloudwatch.test", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source_cloudwatch.test", "default_region", "us-west-2"),
+					resource.TestCheckResourceAttr("grafana_data_source_cloudwatch.test", "auth_type", "arn"),
+					resource.TestCheckResourceAttr("grafana_data_source_cloudwatch.test", "assume_role_arn", "arn:aws:iam::123456789012:role/acc-test"),
+					resource.TestCheckResourceAttr("grafana_data_source_cloudwatch.test", "external_id", "acc-test-external-id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,66 @@
+package grafana
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var dataSourceElasticsearchJSONDataAttrs = []jsonDataAttr{
+	{schemaKey: "index_name", jsonKey: "index"},
+	{schemaKey: "time_field", jsonKey: "timeField"},
+	{schemaKey: "interval_pattern", jsonKey: "interval"},
+	{schemaKey: "es_version", jsonKey: "esVersion"},
+	{schemaKey: "max_concurrent_shard_requests", jsonKey: "maxConcurrentShardRequests"},
+}
+
+// ResourceDataSourceElasticsearch returns a typed data source resource for
+// Grafana's built-in Elasticsearch plugin. See data_source_typed.go for the
+// plumbing shared with the other `grafana_data_source_*` resources.
+func ResourceDataSourceElasticsearch() *schema.Resource {
+	return newTypedDataSourceResource(
+		"elasticsearch",
+		`
+Manages a Grafana data source for Elasticsearch.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/datasources/elasticsearch/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/data_source/)
+
+This is a typed alternative to `+"`grafana_data_source`"+` (`+"`type = \"elasticsearch\"`"+`): it
+exposes Elasticsearch-specific options as plain Terraform attributes instead
+of a hand-built `+"`json_data_encoded`"+` blob.
+`,
+		map[string]*schema.Schema{
+			"index_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The index name, or index pattern, to query.",
+			},
+			"time_field": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "@timestamp",
+				Description: "The field used to filter documents by time. Defaults to `@timestamp`.",
+			},
+			"interval_pattern": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "No pattern",
+				ValidateFunc: validation.StringInSlice([]string{"No pattern", "Hourly", "Daily", "Weekly", "Monthly", "Yearly"}, false),
+				Description:  "The index pattern rotation Grafana should use when resolving `index_name`: `No pattern`, `Hourly`, `Daily`, `Weekly`, `Monthly` or `Yearly`.",
+			},
+			"es_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     70,
+				Description: "The Elasticsearch major version, e.g. `70` for 7.x or `80` for 8.x. Defaults to `70`.",
+			},
+			"max_concurrent_shard_requests": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     5,
+				Description: "The maximum number of concurrent shard requests per query. Defaults to `5`.",
+			},
+		},
+		dataSourceElasticsearchJSONDataAttrs,
+	)
+}
@@ -0,0 +1,52 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	gapi "github.com/grafana/grafana-api-golang-client"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+)
+
+func TestAccDataSourceElasticsearch_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dataSource gapi.DataSource
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      testAccDataSourceCheckDestroy(&dataSource),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "grafana_data_source_elasticsearch" "test" {
+					name       = "elasticsearch-acc-test"
+					url        = "http://localhost:9200"
+					index_name = "logs-*"
+					es_version = 70
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceCheckExists("grafana_data_source_elasticsearch.test", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source_elasticsearch.test", "index_name", "logs-*"),
+					resource.TestCheckResourceAttr("grafana_data_source_elasticsearch.test", "es_version", "70"),
+				),
+			},
+			{
+				Config: `
+				resource "grafana_data_source_elasticsearch" "test" {
+					name                          = "elasticsearch-acc-test"
+					url                           = "http://localhost:9200"
+					index_name                    = "logs-*"
+					es_version                    = 80
+					max_concurrent_shard_requests = 10
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceCheckExists("grafana_data_source_elasticsearch.test", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source_elasticsearch.test", "es_version", "80"),
+					resource.TestCheckResourceAttr("grafana_data_source_elasticsearch.test", "max_concurrent_shard_requests", "10"),
+				),
+			},
+		},
+	})
+}
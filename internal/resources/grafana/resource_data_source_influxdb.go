@@ -0,0 +1,53 @@
+package grafana
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var dataSourceInfluxDBJSONDataAttrs = []jsonDataAttr{
+	{schemaKey: "query_language", jsonKey: "version"},
+	{schemaKey: "http_mode", jsonKey: "httpMode"},
+	{schemaKey: "tls_skip_verify", jsonKey: "tlsSkipVerify"},
+}
+
+// ResourceDataSourceInfluxDB returns a typed data source resource for
+// Grafana's built-in InfluxDB plugin. See data_source_typed.go for the
+// plumbing shared with the other `grafana_data_source_*` resources.
+func ResourceDataSourceInfluxDB() *schema.Resource {
+	return newTypedDataSourceResource(
+		"influxdb",
+		`
+Manages a Grafana data source for InfluxDB.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/datasources/influxdb/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/data_source/)
+
+This is a typed alternative to `+"`grafana_data_source`"+` (`+"`type = \"influxdb\"`"+`): it
+exposes InfluxDB-specific options as plain Terraform attributes instead of a
+hand-built `+"`json_data_encoded`"+` blob.
+`,
+		map[string]*schema.Schema{
+			"query_language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "InfluxQL",
+				ValidateFunc: validation.StringInSlice([]string{"InfluxQL", "Flux"}, false),
+				Description:  "The query language to use: `InfluxQL` or `Flux`. Defaults to `InfluxQL`.",
+			},
+			"http_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "GET",
+				ValidateFunc: validation.StringInSlice([]string{"GET", "POST"}, false),
+				Description:  "The HTTP method used to query the InfluxDB API: `GET` or `POST`. Defaults to `GET`.",
+			},
+			"tls_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether to skip TLS certificate verification when querying this data source.",
+			},
+		},
+		dataSourceInfluxDBJSONDataAttrs,
+	)
+}
@@ -0,0 +1,51 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	gapi "github.com/grafana/grafana-api-golang-client"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+)
+
+func TestAccDataSourceInfluxDB_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dataSource gapi.DataSource
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      testAccDataSourceCheckDestroy(&dataSource),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "grafana_data_source_influxdb" "test" {
+					name           = "influxdb-acc-test"
+					url            = "http://localhost:8086"
+					query_language = "InfluxQL"
+					http_mode      = "GET"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceCheckExists("grafana_data_source_influxdb.test", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source_influxdb.test", "query_language", "InfluxQL"),
+					resource.TestCheckResourceAttr("grafana_data_source_influxdb.test", "http_mode", "GET"),
+				),
+			},
+			{
+				Config: `
+				resource "grafana_data_source_influxdb" "test" {
+					name           = "influxdb-acc-test"
+					url            = "http://localhost:8086"
+					query_language = "Flux"
+					http_mode      = "POST"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceCheckExists("grafana_data_source_influxdb.test", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source_influxdb.test", "query_language", "Flux"),
+					resource.TestCheckResourceAttr("grafana_data_source_influxdb.test", "http_mode", "POST"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,64 @@
+package grafana
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var dataSourceLokiJSONDataAttrs = []jsonDataAttr{
+	{schemaKey: "max_lines", jsonKey: "maxLines"},
+	{schemaKey: "tls_skip_verify", jsonKey: "tlsSkipVerify"},
+	{schemaKey: "tls_client_cert", jsonKey: "tlsClientCert", secure: true},
+	{schemaKey: "tls_client_key", jsonKey: "tlsClientKey", secure: true},
+	{schemaKey: "tls_ca_cert", jsonKey: "tlsCACert", secure: true},
+}
+
+// ResourceDataSourceLoki returns a typed data source resource for Grafana's
+// built-in Loki plugin. See data_source_typed.go for the plumbing shared
+// with the other `grafana_data_source_*` resources.
+func ResourceDataSourceLoki() *schema.Resource {
+	return newTypedDataSourceResource(
+		"loki",
+		`
+Manages a Grafana data source for Loki.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/datasources/loki/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/data_source/)
+
+This is a typed alternative to `+"`grafana_data_source`"+` (`+"`type = \"loki\"`"+`): it
+exposes Loki-specific options as plain Terraform attributes instead of a
+hand-built `+"`json_data_encoded`"+` blob.
+`,
+		map[string]*schema.Schema{
+			"max_lines": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1000,
+				Description: "The maximum number of log lines returned for a query. Defaults to `1000`.",
+			},
+			"tls_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether to skip TLS certificate verification when querying this data source.",
+			},
+			"tls_client_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The client TLS certificate, in PEM format, used for mutual TLS authentication with Loki.",
+			},
+			"tls_client_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The client TLS key, in PEM format, used for mutual TLS authentication with Loki.",
+			},
+			"tls_ca_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The CA certificate, in PEM format, used to verify Loki's TLS certificate.",
+			},
+		},
+		dataSourceLokiJSONDataAttrs,
+	)
+}
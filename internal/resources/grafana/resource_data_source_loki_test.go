@@ -0,0 +1,49 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	gapi "github.com/grafana/grafana-api-golang-client"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+)
+
+func TestAccDataSourceLoki_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dataSource gapi.DataSource
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      testAccDataSourceCheckDestroy(&dataSource),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "grafana_data_source_loki" "test" {
+					name      = "loki-acc-test"
+					url       = "http://localhost:3100"
+					max_lines = 500
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceCheckExists("grafana_data_source_loki.test", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source_loki.test", "max_lines", "500"),
+				),
+			},
+			{
+				Config: `
+				resource "grafana_data_source_loki" "test" {
+					name            = "loki-acc-test"
+					url             = "http://localhost:3100"
+					max_lines       = 2000
+					tls_skip_verify = true
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceCheckExists("grafana_data_source_loki.test", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source_loki.test", "max_lines", "2000"),
+					resource.TestCheckResourceAttr("grafana_data_source_loki.test", "tls_skip_verify", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,89 @@
+package grafana_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+)
+
+func TestAccDataSourcePermission_basic(t *testing.T) {
+	testutils.CheckEnterpriseTestsEnabled(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      testAccDataSourcePermissionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "grafana_data_source" "test" {
+					type = "prometheus"
+					name = "permission-acc-test"
+					url  = "http://localhost:9090"
+				}
+
+				resource "grafana_team" "test" {
+					name = "permission-acc-test"
+				}
+
+				resource "grafana_data_source_permission" "test" {
+					datasource_uid = grafana_data_source.test.uid
+
+					permission {
+						team_id    = grafana_team.test.id
+						permission = "Query"
+					}
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourcePermissionCheckExists("grafana_data_source_permission.test"),
+					resource.TestCheckResourceAttr("grafana_data_source_permission.test", "permission.#", "1"),
+					resource.TestCheckResourceAttr("grafana_data_source_permission.test", "permission.0.permission", "Query"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePermissionCheckExists(rn string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[rn]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", rn)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("resource %s has no ID set", rn)
+		}
+
+		client := testutils.Provider.Meta().(*common.Client).GrafanaAPI
+		id, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+		if _, err := client.DataSourcePermissions(id); err != nil {
+			return fmt.Errorf("error getting data source permissions %s: %s", rn, err)
+		}
+		return nil
+	}
+}
+
+func testAccDataSourcePermissionCheckDestroy(s *terraform.State) error {
+	client := testutils.Provider.Meta().(*common.Client).GrafanaAPI
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "grafana_data_source" {
+			continue
+		}
+		id, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+		if _, err := client.DataSource(id); err == nil {
+			return fmt.Errorf("data source %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
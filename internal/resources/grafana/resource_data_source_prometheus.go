@@ -0,0 +1,78 @@
+package grafana
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var dataSourcePrometheusJSONDataAttrs = []jsonDataAttr{
+	{schemaKey: "scrape_interval", jsonKey: "timeInterval"},
+	{schemaKey: "query_timeout", jsonKey: "queryTimeout"},
+	{schemaKey: "http_method", jsonKey: "httpMethod"},
+	{schemaKey: "tls_skip_verify", jsonKey: "tlsSkipVerify"},
+	{schemaKey: "tls_client_cert", jsonKey: "tlsClientCert", secure: true},
+	{schemaKey: "tls_client_key", jsonKey: "tlsClientKey", secure: true},
+	{schemaKey: "tls_ca_cert", jsonKey: "tlsCACert", secure: true},
+}
+
+// ResourceDataSourcePrometheus returns a typed data source resource for
+// Grafana's built-in Prometheus plugin. See data_source_typed.go for the
+// plumbing shared with the other `grafana_data_source_*` resources.
+func ResourceDataSourcePrometheus() *schema.Resource {
+	return newTypedDataSourceResource(
+		"prometheus",
+		`
+Manages a Grafana data source for Prometheus.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/datasources/prometheus/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/data_source/)
+
+This is a typed alternative to `+"`grafana_data_source`"+` (`+"`type = \"prometheus\"`"+`): it
+exposes Prometheus-specific options as plain Terraform attributes instead of
+a hand-built `+"`json_data_encoded`"+` blob.
+`,
+		map[string]*schema.Schema{
+			"scrape_interval": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Set this to the typical scrape and evaluation interval configured in Prometheus, e.g. `15s`. Defaults to Grafana's global scrape interval if unset.",
+			},
+			"query_timeout": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Timeout for queries made against this data source, e.g. `60s`.",
+			},
+			"http_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "POST",
+				ValidateFunc: validation.StringInSlice([]string{"GET", "POST"}, false),
+				Description:  "The HTTP method used to query the Prometheus API: `GET` or `POST`. Defaults to `POST`.",
+			},
+			"tls_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether to skip TLS certificate verification when querying this data source.",
+			},
+			"tls_client_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The client TLS certificate, in PEM format, used for mutual TLS authentication with Prometheus.",
+			},
+			"tls_client_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The client TLS key, in PEM format, used for mutual TLS authentication with Prometheus.",
+			},
+			"tls_ca_cert": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The CA certificate, in PEM format, used to verify Prometheus's TLS certificate.",
+			},
+		},
+		dataSourcePrometheusJSONDataAttrs,
+	)
+}
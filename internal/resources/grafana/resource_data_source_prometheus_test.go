@@ -0,0 +1,95 @@
+package grafana_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	gapi "github.com/grafana/grafana-api-golang-client"
+	"github.com/grafana/terraform-provider-grafana/internal/common"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+)
+
+func TestAccDataSourcePrometheus_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dataSource gapi.DataSource
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      testAccDataSourceCheckDestroy(&dataSource),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "grafana_data_source_prometheus" "test" {
+					name            = "prometheus-acc-test"
+					url             = "http://localhost:9090"
+					scrape_interval = "15s"
+					http_method     = "GET"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceCheckExists("grafana_data_source_prometheus.test", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source_prometheus.test", "name", "prometheus-acc-test"),
+					resource.TestCheckResourceAttr("grafana_data_source_prometheus.test", "scrape_interval", "15s"),
+					resource.TestCheckResourceAttr("grafana_data_source_prometheus.test", "http_method", "GET"),
+				),
+			},
+			{
+				Config: `
+				resource "grafana_data_source_prometheus" "test" {
+					name            = "prometheus-acc-test"
+					url             = "http://localhost:9090"
+					scrape_interval = "30s"
+					http_method     = "POST"
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceCheckExists("grafana_data_source_prometheus.test", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source_prometheus.test", "scrape_interval", "30s"),
+					resource.TestCheckResourceAttr("grafana_data_source_prometheus.test", "http_method", "POST"),
+				),
+			},
+		},
+	})
+}
+
+// testAccDataSourceCheckExists returns a TestCheckFunc that looks up the
+// data source by the ID Terraform recorded for rn and stores the result in
+// dataSource, shared by the acceptance tests for the generic and typed
+// `grafana_data_source*` resources.
+func testAccDataSourceCheckExists(rn string, dataSource *gapi.DataSource) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[rn]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", rn)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("resource %s has no ID set", rn)
+		}
+
+		client := testutils.Provider.Meta().(*common.Client).GrafanaAPI
+		id, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+		got, err := client.DataSource(id)
+		if err != nil {
+			return fmt.Errorf("error getting data source %s: %s", rn, err)
+		}
+		*dataSource = *got
+		return nil
+	}
+}
+
+func testAccDataSourceCheckDestroy(dataSource *gapi.DataSource) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testutils.Provider.Meta().(*common.Client).GrafanaAPI
+		_, err := client.DataSource(dataSource.ID)
+		if err == nil {
+			return fmt.Errorf("data source %d still exists", dataSource.ID)
+		}
+		return nil
+	}
+}
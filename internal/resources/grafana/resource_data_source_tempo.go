@@ -0,0 +1,48 @@
+package grafana
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var dataSourceTempoJSONDataAttrs = []jsonDataAttr{
+	{schemaKey: "tracing_to_logs_datasource_uid", jsonKey: "tracesToLogsDatasourceUid"},
+	{schemaKey: "service_map_datasource_uid", jsonKey: "serviceMapDatasourceUid"},
+	{schemaKey: "node_graph_enabled", jsonKey: "nodeGraphEnabled"},
+}
+
+// ResourceDataSourceTempo returns a typed data source resource for Grafana's
+// built-in Tempo plugin. See data_source_typed.go for the plumbing shared
+// with the other `grafana_data_source_*` resources.
+func ResourceDataSourceTempo() *schema.Resource {
+	return newTypedDataSourceResource(
+		"tempo",
+		`
+Manages a Grafana data source for Tempo.
+
+* [Official documentation](https://grafana.com/docs/grafana/latest/datasources/tempo/)
+* [HTTP API](https://grafana.com/docs/grafana/latest/developers/http_api/data_source/)
+
+This is a typed alternative to `+"`grafana_data_source`"+` (`+"`type = \"tempo\"`"+`): it
+exposes Tempo's trace-to-logs and service graph linking options as plain
+Terraform attributes instead of a hand-built `+"`json_data_encoded`"+` blob.
+`,
+		map[string]*schema.Schema{
+			"tracing_to_logs_datasource_uid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The UID of the logs data source (e.g. Loki) used for trace-to-logs correlation.",
+			},
+			"service_map_datasource_uid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The UID of the Prometheus data source used to render the service graph.",
+			},
+			"node_graph_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether to enable the node graph visualization for traces from this data source.",
+			},
+		},
+		dataSourceTempoJSONDataAttrs,
+	)
+}
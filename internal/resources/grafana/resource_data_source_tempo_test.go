@@ -0,0 +1,61 @@
+package grafana_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	gapi "github.com/grafana/grafana-api-golang-client"
+	"github.com/grafana/terraform-provider-grafana/internal/testutils"
+)
+
+func TestAccDataSourceTempo_basic(t *testing.T) {
+	testutils.CheckOSSTestsEnabled(t)
+
+	var dataSource gapi.DataSource
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testutils.ProviderFactories,
+		CheckDestroy:      testAccDataSourceCheckDestroy(&dataSource),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "grafana_data_source_loki" "logs" {
+					name = "tempo-acc-test-logs"
+					url  = "http://localhost:3100"
+				}
+
+				resource "grafana_data_source_tempo" "test" {
+					name                           = "tempo-acc-test"
+					url                            = "http://localhost:3200"
+					tracing_to_logs_datasource_uid = grafana_data_source_loki.logs.uid
+					node_graph_enabled             = true
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceCheckExists("grafana_data_source_tempo.test", &dataSource),
+					resource.TestCheckResourceAttrPair("grafana_data_source_tempo.test", "tracing_to_logs_datasource_uid", "grafana_data_source_loki.logs", "uid"),
+					resource.TestCheckResourceAttr("grafana_data_source_tempo.test", "node_graph_enabled", "true"),
+				),
+			},
+			{
+				Config: `
+				resource "grafana_data_source_loki" "logs" {
+					name = "tempo-acc-test-logs"
+					url  = "http://localhost:3100"
+				}
+
+				resource "grafana_data_source_tempo" "test" {
+					name                       = "tempo-acc-test"
+					url                        = "http://localhost:3200"
+					service_map_datasource_uid = "prometheus-uid"
+					node_graph_enabled         = false
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceCheckExists("grafana_data_source_tempo.test", &dataSource),
+					resource.TestCheckResourceAttr("grafana_data_source_tempo.test", "service_map_datasource_uid", "prometheus-uid"),
+					resource.TestCheckResourceAttr("grafana_data_source_tempo.test", "node_graph_enabled", "false"),
+				),
+			},
+		},
+	})
+}